@@ -1,5 +1,12 @@
 package common
 
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
 const (
 	// AnnotationName is the annotation on child resources that specifies which ArgoCD instance
 	// name a specific object is associated with
@@ -13,3 +20,71 @@ const (
 	// request a TLS certificate from OpenShift's Service CA for AutoTLS
 	AnnotationOpenShiftServiceCA = "service.beta.openshift.io/serving-cert-secret-name"
 )
+
+// AnnotationEnricher supplies additional annotations to be added to the default set of annotations.
+type AnnotationEnricher interface {
+	Enrich(name, namespace string) map[string]string
+}
+
+// StaticAnnotationEnricher is an AnnotationEnricher that adds the same set of annotations to every resource.
+type StaticAnnotationEnricher map[string]string
+
+// Enrich returns a copy of the static annotations, regardless of the given resource.
+func (e StaticAnnotationEnricher) Enrich(name, namespace string) map[string]string {
+	annotations := make(map[string]string, len(e))
+	for k, v := range e {
+		annotations[k] = v
+	}
+	return annotations
+}
+
+// annotationEnrichers are the enrichers applied by DefaultAnnotations.
+var annotationEnrichers []AnnotationEnricher
+
+// RegisterAnnotationEnricher adds the given AnnotationEnricher to the enrichers applied by DefaultAnnotations.
+// It is not safe for concurrent use and should only be called during operator startup.
+func RegisterAnnotationEnricher(enricher AnnotationEnricher) {
+	annotationEnrichers = append(annotationEnrichers, enricher)
+}
+
+// DefaultAnnotationsWithEnrichment returns the default set of annotations for the given resource, merged with the
+// annotations supplied by the given enrichers. Enrichers are applied in order, but can never override the default
+// annotations.
+func DefaultAnnotationsWithEnrichment(name, namespace string, enrichers ...AnnotationEnricher) map[string]string {
+	annotations := map[string]string{
+		AnnotationName:      name,
+		AnnotationNamespace: namespace,
+	}
+
+	for _, enricher := range enrichers {
+		for k, v := range enricher.Enrich(name, namespace) {
+			if k == AnnotationName || k == AnnotationNamespace {
+				continue
+			}
+			annotations[k] = v
+		}
+	}
+	return annotations
+}
+
+// ParseAnnotations parses annotations in the form "foo=bar,baz=qux". Whitespace around keys and values is trimmed
+// and empty entries, such as a trailing comma, are ignored. As entries are separated by commas, annotation values
+// cannot contain a comma.
+func ParseAnnotations(s string) (map[string]string, error) {
+	annotations := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid annotation %q, must be in the form key=value", pair)
+		}
+		key := strings.TrimSpace(kv[0])
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid annotation key %q: %s", key, strings.Join(errs, "; "))
+		}
+		annotations[key] = strings.TrimSpace(kv[1])
+	}
+	return annotations, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2020 ArgoCD Operator Developers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// knownComponents is the set of values used for the ArgoCDKeyComponent label on resources managed by the operator.
+var knownComponents = map[string]bool{
+	"application-controller":    true,
+	"applicationset-controller": true,
+	"controller":                true,
+	"dex-server":                true,
+	"grafana":                   true,
+	"keycloak":                  true,
+	"metrics":                   true,
+	"redis":                     true,
+	"redis-ha-server":           true,
+	"repo-server":               true,
+	"server":                    true,
+}
+
+// ValidComponent returns an error if the given component is not one of the known ArgoCD component names.
+func ValidComponent(component string) error {
+	if knownComponents[component] {
+		return nil
+	}
+
+	names := make([]string, 0, len(knownComponents))
+	for name := range knownComponents {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Errorf("unknown component %q, must be one of: %s", component, strings.Join(names, ", "))
+}
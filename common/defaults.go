@@ -308,21 +308,27 @@ vs-ssh.visualstudio.com ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC7Hr1oTWqNqOlzGJOf
 	OperatorMetricsPort = 8080
 )
 
-// DefaultLabels returns the default set of labels for controllers.
+// DefaultLabels returns the default set of labels for controllers, including the OperatorVersionLabel.
+// Labels supplied by any registered LabelEnricher are included as well.
 func DefaultLabels(name string) map[string]string {
-	return map[string]string{
-		ArgoCDKeyName:      name,
-		ArgoCDKeyPartOf:    ArgoCDAppName,
-		ArgoCDKeyManagedBy: name,
+	return DefaultComponentLabels(name, name, "")
+}
+
+// DefaultComponentLabels returns the default set of labels for the named resource of the given component, owned by
+// the given ArgoCD instance, including the OperatorVersionLabel when the operator version is a valid label value.
+// Labels supplied by any registered LabelEnricher are included as well.
+func DefaultComponentLabels(name, instance, component string) map[string]string {
+	labels := DefaultLabelsWithEnrichment(name, instance, component, labelEnrichers...)
+	if v, _ := OperatorVersionLabelValue(); v != "" {
+		labels[OperatorVersionLabel] = v
 	}
+	return labels
 }
 
 // DefaultAnnotations returns the default set of annotations for child resources of ArgoCD
+// Annotations supplied by any registered AnnotationEnricher are included as well.
 func DefaultAnnotations(name string, namespace string) map[string]string {
-	return map[string]string{
-		AnnotationName:      name,
-		AnnotationNamespace: namespace,
-	}
+	return DefaultAnnotationsWithEnrichment(name, namespace, annotationEnrichers...)
 }
 
 // DefaultNodeSelector returns the defult nodeSelector for ArgoCD workloads
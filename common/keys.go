@@ -40,6 +40,12 @@ const (
 	// ArgoCDKeyComponent is the resource component key for labels.
 	ArgoCDKeyComponent = "app.kubernetes.io/component"
 
+	// OperatorVersionLabel is the label key for the version of the operator that created a resource.
+	// Existing resources are not relabelled on upgrade, except where the reconciler syncs metadata labels on
+	// update (currently the ApplicationSet and Notifications controller Deployments), where it tracks the
+	// version that last updated the resource.
+	OperatorVersionLabel = "argocd-operator.argoproj.io/operator-version"
+
 	// ArgoCDKeyDexOAuthRedirectURI is the key for the OAuth Redirect URI annotation.
 	ArgoCDKeyDexOAuthRedirectURI = "serviceaccounts.openshift.io/oauth-redirecturi.argocd"
 
@@ -231,4 +237,12 @@ const (
 
 	// Label Selector is an env variable for ArgoCD instance reconcilliation.
 	ArgoCDLabelSelectorKey = "ARGOCD_LABEL_SELECTOR"
+
+	// ArgoCDExtraLabelsKey is an env variable for extra labels to be added to resources that carry the operator's
+	// default labels.
+	ArgoCDExtraLabelsKey = "ARGOCD_EXTRA_LABELS"
+
+	// ArgoCDExtraAnnotationsKey is an env variable for extra annotations to be added to resources that carry the
+	// operator's default annotations.
+	ArgoCDExtraAnnotationsKey = "ARGOCD_EXTRA_ANNOTATIONS"
 )
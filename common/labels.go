@@ -0,0 +1,96 @@
+// Copyright 2020 ArgoCD Operator Developers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/argoproj-labs/argocd-operator/version"
+)
+
+// LabelEnricher supplies additional labels to be added to the default set of labels. It is given the name of the
+// resource being labelled, the name of the owning ArgoCD instance and the component, which is empty for resources
+// that do not belong to a single component.
+type LabelEnricher interface {
+	Enrich(name, instance, component string) map[string]string
+}
+
+// StaticLabelEnricher is a LabelEnricher that adds the same set of labels to every resource.
+type StaticLabelEnricher map[string]string
+
+// Enrich returns a copy of the static labels, regardless of the given resource.
+func (e StaticLabelEnricher) Enrich(name, instance, component string) map[string]string {
+	labels := make(map[string]string, len(e))
+	for k, v := range e {
+		labels[k] = v
+	}
+	return labels
+}
+
+// labelEnrichers are the enrichers applied by DefaultLabels and DefaultComponentLabels.
+var labelEnrichers []LabelEnricher
+
+// RegisterLabelEnricher adds the given LabelEnricher to the enrichers applied by DefaultLabels and
+// DefaultComponentLabels.
+// It is not safe for concurrent use and should only be called during operator startup.
+func RegisterLabelEnricher(enricher LabelEnricher) {
+	labelEnrichers = append(labelEnrichers, enricher)
+}
+
+// reservedLabels are the label keys set by the operator, which enrichers can never set.
+var reservedLabels = map[string]bool{
+	ArgoCDKeyName:        true,
+	ArgoCDKeyPartOf:      true,
+	ArgoCDKeyManagedBy:   true,
+	ArgoCDKeyComponent:   true,
+	OperatorVersionLabel: true,
+}
+
+// DefaultLabelsWithEnrichment returns the default set of labels for the given resource, merged with the labels
+// supplied by the given enrichers. Enrichers are applied in order, but can never set the reserved label keys.
+func DefaultLabelsWithEnrichment(name, instance, component string, enrichers ...LabelEnricher) map[string]string {
+	labels := make(map[string]string)
+	for _, enricher := range enrichers {
+		for k, v := range enricher.Enrich(name, instance, component) {
+			if !reservedLabels[k] {
+				labels[k] = v
+			}
+		}
+	}
+
+	labels[ArgoCDKeyName] = name
+	labels[ArgoCDKeyPartOf] = ArgoCDAppName
+	labels[ArgoCDKeyManagedBy] = instance
+	if component != "" {
+		labels[ArgoCDKeyComponent] = component
+	}
+	return labels
+}
+
+// OperatorVersionLabelValue returns the operator version set at build time, for use as the value of the
+// OperatorVersionLabel. It returns an empty string if the version is unset, and an error if the version is not a
+// valid label value.
+func OperatorVersionLabelValue() (string, error) {
+	if version.Version == "" {
+		return "", nil
+	}
+	if errs := validation.IsValidLabelValue(version.Version); len(errs) > 0 {
+		return "", fmt.Errorf("operator version %q is not a valid label value: %s", version.Version, strings.Join(errs, "; "))
+	}
+	return version.Version, nil
+}
@@ -375,13 +375,12 @@ func newDeployment(cr *argoproj.ArgoCD) *appsv1.Deployment {
 
 // newDeploymentWithName returns a new Deployment instance for the given ArgoCD using the given name.
 func newDeploymentWithName(name string, component string, cr *argoproj.ArgoCD) *appsv1.Deployment {
+	logInvalidComponent("Deployment", name, component)
+
 	deploy := newDeployment(cr)
 	deploy.ObjectMeta.Name = name
 
-	lbls := deploy.ObjectMeta.Labels
-	lbls[common.ArgoCDKeyName] = name
-	lbls[common.ArgoCDKeyComponent] = component
-	deploy.ObjectMeta.Labels = lbls
+	deploy.ObjectMeta.Labels = common.DefaultComponentLabels(name, cr.Name, component)
 
 	deploy.Spec = appsv1.DeploymentSpec{
 		Selector: &metav1.LabelSelector{
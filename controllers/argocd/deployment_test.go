@@ -2,7 +2,12 @@ package argocd
 
 import (
 	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -2005,3 +2010,107 @@ func TestArgoCDRepoServerDeploymentCommand(t *testing.T) {
 
 	assert.Equal(t, baseCommand, deployment.Spec.Template.Spec.Containers[0].Command)
 }
+
+// stringConstants returns the string constants declared in the non-test Go files of dir, keyed by name prefixed
+// with the given qualifier.
+func stringConstants(t *testing.T, fset *token.FileSet, dir, qualifier string) (map[string]string, map[string]*ast.Package) {
+	nonTest := func(fi os.FileInfo) bool { return !strings.HasSuffix(fi.Name(), "_test.go") }
+	pkgs, err := parser.ParseDir(fset, dir, nonTest, 0)
+	assert.NoError(t, err)
+
+	consts := map[string]string{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.CONST {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					vs := spec.(*ast.ValueSpec)
+					for i, name := range vs.Names {
+						if i >= len(vs.Values) {
+							continue
+						}
+						if lit, ok := vs.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+							value, err := strconv.Unquote(lit.Value)
+							assert.NoError(t, err)
+							consts[qualifier+name.Name] = value
+						}
+					}
+				}
+			}
+		}
+	}
+	return consts, pkgs
+}
+
+// TestConstructorComponentsAreValid walks every call to the component-taking constructors in this package and
+// verifies that the component passed is known to common.ValidComponent.
+func TestConstructorComponentsAreValid(t *testing.T) {
+	constructors := map[string]bool{
+		"newDeploymentWithName":    true,
+		"newDeploymentWithSuffix":  true,
+		"newStatefulSetWithName":   true,
+		"newStatefulSetWithSuffix": true,
+		"newServiceWithName":       true,
+		"newServiceWithSuffix":     true,
+	}
+
+	fset := token.NewFileSet()
+	consts, pkgs := stringConstants(t, fset, ".", "")
+	commonConsts, _ := stringConstants(t, fset, "../../common", "common.")
+	for k, v := range commonConsts {
+		consts[k] = v
+	}
+
+	found := map[string]bool{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok || len(call.Args) < 2 {
+					return true
+				}
+				fn, ok := call.Fun.(*ast.Ident)
+				if !ok || !constructors[fn.Name] {
+					return true
+				}
+
+				var component string
+				switch arg := call.Args[1].(type) {
+				case *ast.BasicLit:
+					var err error
+					component, err = strconv.Unquote(arg.Value)
+					ok = err == nil
+				case *ast.Ident:
+					if arg.Name == "component" {
+						// passed through from a wrapping constructor
+						return true
+					}
+					component, ok = consts[arg.Name]
+				case *ast.SelectorExpr:
+					if pkgIdent, isIdent := arg.X.(*ast.Ident); isIdent {
+						component, ok = consts[pkgIdent.Name+"."+arg.Sel.Name]
+					} else {
+						ok = false
+					}
+				default:
+					ok = false
+				}
+				if !ok {
+					t.Errorf("%s: cannot resolve component argument of %s to a string constant", fset.Position(call.Pos()), fn.Name)
+					return true
+				}
+
+				found[component] = true
+				assert.NoError(t, common.ValidComponent(component), fset.Position(call.Pos()).String())
+				return true
+			})
+		}
+	}
+
+	for _, component := range []string{"controller", "metrics", "server", "redis"} {
+		assert.True(t, found[component], "expected a constructor call with component %q", component)
+	}
+}
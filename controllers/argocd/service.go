@@ -49,13 +49,12 @@ func newService(cr *argoproj.ArgoCD) *corev1.Service {
 
 // newServiceWithName returns a new Service instance for the given ArgoCD using the given name.
 func newServiceWithName(name string, component string, cr *argoproj.ArgoCD) *corev1.Service {
+	logInvalidComponent("Service", name, component)
+
 	svc := newService(cr)
 	svc.ObjectMeta.Name = name
 
-	lbls := svc.ObjectMeta.Labels
-	lbls[common.ArgoCDKeyName] = name
-	lbls[common.ArgoCDKeyComponent] = component
-	svc.ObjectMeta.Labels = lbls
+	svc.ObjectMeta.Labels = common.DefaultComponentLabels(name, cr.Name, component)
 
 	return svc
 }
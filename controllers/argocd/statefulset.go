@@ -52,13 +52,12 @@ func newStatefulSet(cr *argoproj.ArgoCD) *appsv1.StatefulSet {
 
 // newStatefulSetWithName returns a new StatefulSet instance for the given ArgoCD using the given name.
 func newStatefulSetWithName(name string, component string, cr *argoproj.ArgoCD) *appsv1.StatefulSet {
+	logInvalidComponent("StatefulSet", name, component)
+
 	ss := newStatefulSet(cr)
 	ss.ObjectMeta.Name = name
 
-	lbls := ss.ObjectMeta.Labels
-	lbls[common.ArgoCDKeyName] = name
-	lbls[common.ArgoCDKeyComponent] = component
-	ss.ObjectMeta.Labels = lbls
+	ss.ObjectMeta.Labels = common.DefaultComponentLabels(name, cr.Name, component)
 
 	ss.Spec = appsv1.StatefulSetSpec{
 		Selector: &metav1.LabelSelector{
@@ -629,6 +629,15 @@ func fqdnServiceRef(service string, port int, cr *argoproj.ArgoCD) string {
 	return fmt.Sprintf("%s.%s.svc.cluster.local:%d", nameWithSuffix(service, cr), cr.Namespace, port)
 }
 
+// logInvalidComponent logs an error when the given component is not known to common.ValidComponent. The resource
+// constructors cannot return an error, so unknown components are only logged here; they are rejected by
+// TestConstructorComponentsAreValid, which checks every constructor call site in this package.
+func logInvalidComponent(kind, name, component string) {
+	if err := common.ValidComponent(component); err != nil {
+		log.Error(err, fmt.Sprintf("invalid component for %s %s", kind, name))
+	}
+}
+
 // InspectCluster will verify the availability of extra features available to the cluster, such as Prometheus and
 // OpenShift Routes.
 func InspectCluster() error {
@@ -22,6 +22,7 @@ import (
 
 	argoproj "github.com/argoproj-labs/argocd-operator/api/v1beta1"
 	"github.com/argoproj-labs/argocd-operator/common"
+	"github.com/argoproj-labs/argocd-operator/version"
 )
 
 func TestDefaultAnnotations(t *testing.T) {
@@ -57,3 +58,184 @@ func TestDefaultAnnotations(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultAnnotationsWithEnrichment(t *testing.T) {
+	enrichers := []common.AnnotationEnricher{
+		common.StaticAnnotationEnricher{"owner": "a", "argocds.argoproj.io/name": "bar"},
+		common.StaticAnnotationEnricher{"owner": "b"},
+	}
+	want := map[string]string{
+		"argocds.argoproj.io/name":      "foo",
+		"argocds.argoproj.io/namespace": "ns",
+		"owner":                         "b",
+	}
+	if got := common.DefaultAnnotationsWithEnrichment("foo", "ns", enrichers...); !reflect.DeepEqual(got, want) {
+		t.Errorf("DefaultAnnotationsWithEnrichment() = %v, want %v", got, want)
+	}
+}
+
+func TestParseAnnotations(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "empty",
+			input: "",
+			want:  map[string]string{},
+		},
+		{
+			name:  "multiple annotations",
+			input: "foo=bar,example.com/baz=qux",
+			want:  map[string]string{"foo": "bar", "example.com/baz": "qux"},
+		},
+		{
+			name:  "trailing comma",
+			input: "foo=bar,",
+			want:  map[string]string{"foo": "bar"},
+		},
+		{
+			name:  "whitespace",
+			input: " foo = bar , baz=qux ",
+			want:  map[string]string{"foo": "bar", "baz": "qux"},
+		},
+		{
+			name:  "empty value and value with equals",
+			input: "foo=,bar=a=b",
+			want:  map[string]string{"foo": "", "bar": "a=b"},
+		},
+		{
+			name:    "missing key",
+			input:   "=v",
+			wantErr: true,
+		},
+		{
+			name:    "missing value separator",
+			input:   "foo",
+			wantErr: true,
+		},
+		{
+			name:    "invalid key",
+			input:   "foo bar=baz",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := common.ParseAnnotations(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAnnotations() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseAnnotations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultLabelsWithEnrichment(t *testing.T) {
+	tests := []struct {
+		name      string
+		component string
+		enrichers []common.LabelEnricher
+		want      map[string]string
+	}{
+		{
+			name: "no enrichers",
+			want: map[string]string{
+				"app.kubernetes.io/name":       "foo",
+				"app.kubernetes.io/part-of":    "argocd",
+				"app.kubernetes.io/managed-by": "foo",
+			},
+		},
+		{
+			name:      "component and static enricher",
+			component: "server",
+			enrichers: []common.LabelEnricher{
+				common.StaticLabelEnricher{"cost-center": "1234"},
+			},
+			want: map[string]string{
+				"app.kubernetes.io/name":       "foo",
+				"app.kubernetes.io/part-of":    "argocd",
+				"app.kubernetes.io/managed-by": "foo",
+				"app.kubernetes.io/component":  "server",
+				"cost-center":                  "1234",
+			},
+		},
+		{
+			name: "enrichers cannot override default labels",
+			enrichers: []common.LabelEnricher{
+				common.StaticLabelEnricher{"team": "a", "app.kubernetes.io/name": "bar"},
+				common.StaticLabelEnricher{"team": "b"},
+			},
+			want: map[string]string{
+				"app.kubernetes.io/name":       "foo",
+				"app.kubernetes.io/part-of":    "argocd",
+				"app.kubernetes.io/managed-by": "foo",
+				"team":                         "b",
+			},
+		},
+		{
+			name: "enrichers cannot set reserved labels",
+			enrichers: []common.LabelEnricher{
+				common.StaticLabelEnricher{
+					common.OperatorVersionLabel:   "0.0.1",
+					"app.kubernetes.io/component": "server",
+				},
+			},
+			want: map[string]string{
+				"app.kubernetes.io/name":       "foo",
+				"app.kubernetes.io/part-of":    "argocd",
+				"app.kubernetes.io/managed-by": "foo",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := common.DefaultLabelsWithEnrichment("foo", "foo", tt.component, tt.enrichers...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DefaultLabelsWithEnrichment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultLabels_operatorVersion(t *testing.T) {
+	defer func(v string) { version.Version = v }(version.Version)
+
+	version.Version = "0.9.0"
+	got := common.DefaultLabels("foo")
+	if got[common.OperatorVersionLabel] != "0.9.0" {
+		t.Errorf("DefaultLabels() = %v, want %s=0.9.0", got, common.OperatorVersionLabel)
+	}
+
+	version.Version = "0.9.0+invalid"
+	if _, err := common.OperatorVersionLabelValue(); err == nil {
+		t.Errorf("OperatorVersionLabelValue() expected an error for an invalid label value")
+	}
+	got = common.DefaultLabels("foo")
+	if _, ok := got[common.OperatorVersionLabel]; ok {
+		t.Errorf("DefaultLabels() = %v, want no %s label for an invalid label value", got, common.OperatorVersionLabel)
+	}
+}
+
+func TestValidComponent(t *testing.T) {
+	tests := []struct {
+		component string
+		wantErr   bool
+	}{
+		{component: "server"},
+		{component: "repo-server"},
+		{component: common.ApplicationSetServiceNameSuffix},
+		{component: "argocd-server", wantErr: true},
+		{component: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.component, func(t *testing.T) {
+			if err := common.ValidComponent(tt.component); (err != nil) != tt.wantErr {
+				t.Errorf("ValidComponent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
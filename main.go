@@ -79,6 +79,9 @@ func printVersion() {
 	setupLog.Info(fmt.Sprintf("Go OS/Arch: %s/%s", goruntime.GOOS, goruntime.GOARCH))
 	setupLog.Info(fmt.Sprintf("Version of operator-sdk: %v", sdkVersion.Version))
 	setupLog.Info(fmt.Sprintf("Version of %s-operator: %v", common.ArgoCDAppName, version.Version))
+	if _, err := common.OperatorVersionLabelValue(); err != nil {
+		setupLog.Info(fmt.Sprintf("%s label will not be set on managed resources: %v", common.OperatorVersionLabel, err))
+	}
 }
 
 func main() {
@@ -86,6 +89,8 @@ func main() {
 	var enableLeaderElection bool
 	var probeAddr string
 	var labelSelectorFlag string
+	var extraLabelsFlag string
+	var extraAnnotationsFlag string
 
 	var secureMetrics = false
 	var enableHTTP2 = false
@@ -93,6 +98,8 @@ func main() {
 	flag.StringVar(&metricsAddr, "metrics-bind-address", fmt.Sprintf(":%d", common.OperatorMetricsPort), "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.StringVar(&labelSelectorFlag, "label-selector", env.StringFromEnv(common.ArgoCDLabelSelectorKey, common.ArgoCDDefaultLabelSelector), "The label selector is used to map to a subset of ArgoCD instances to reconcile")
+	flag.StringVar(&extraLabelsFlag, "extra-labels", env.StringFromEnv(common.ArgoCDExtraLabelsKey, ""), "Extra labels, in the form \"foo=bar,baz=qux\", to be added at creation time to resources labelled with the operator's default labels")
+	flag.StringVar(&extraAnnotationsFlag, "extra-annotations", env.StringFromEnv(common.ArgoCDExtraAnnotationsKey, ""), "Extra annotations, in the form \"foo=bar,baz=qux\", to be added at creation time to resources annotated with the operator's default annotations. Values cannot contain commas")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
@@ -134,6 +141,28 @@ func main() {
 	}
 	setupLog.Info(fmt.Sprintf("Watching labelselector \"%s\"", labelSelectorFlag))
 
+	// Check the extra labels format eg. "foo=bar,baz=qux"
+	if extraLabelsFlag != "" {
+		extraLabels, err := labels.ConvertSelectorToLabelsMap(extraLabelsFlag)
+		if err != nil {
+			setupLog.Error(err, fmt.Sprintf("error parsing the extra labels '%s'.", extraLabelsFlag))
+			os.Exit(1)
+		}
+		common.RegisterLabelEnricher(common.StaticLabelEnricher(extraLabels))
+		setupLog.Info(fmt.Sprintf("Adding extra labels \"%s\" to managed resources", extraLabelsFlag))
+	}
+
+	// Check the extra annotations format eg. "foo=bar,baz=qux"
+	if extraAnnotationsFlag != "" {
+		extraAnnotations, err := common.ParseAnnotations(extraAnnotationsFlag)
+		if err != nil {
+			setupLog.Error(err, fmt.Sprintf("error parsing the extra annotations '%s'.", extraAnnotationsFlag))
+			os.Exit(1)
+		}
+		common.RegisterAnnotationEnricher(common.StaticAnnotationEnricher(extraAnnotations))
+		setupLog.Info(fmt.Sprintf("Adding extra annotations \"%s\" to managed resources", extraAnnotationsFlag))
+	}
+
 	// Inspect cluster to verify availability of extra features
 	if err := argocd.InspectCluster(); err != nil {
 		setupLog.Info("unable to inspect cluster")